@@ -0,0 +1,57 @@
+package version
+
+import "sort"
+
+// Collection is a sortable slice of Versions, ordered using Compare so that
+// prerelease precedence and the "release > prerelease" rule are respected.
+type Collection []*Version
+
+// Len implements sort.Interface.
+func (v Collection) Len() int {
+	return len(v)
+}
+
+// Less implements sort.Interface.
+func (v Collection) Less(i, j int) bool {
+	return v[i].Compare(v[j]) < 0
+}
+
+// Swap implements sort.Interface.
+func (v Collection) Swap(i, j int) {
+	v[i], v[j] = v[j], v[i]
+}
+
+// Sort sorts c in place using Compare.
+func Sort(c Collection) {
+	sort.Sort(c)
+}
+
+// SortStable stably sorts c in place using Compare, preserving the relative
+// order of versions that Compare treats as equal (e.g. ones that only
+// differ by build metadata).
+func SortStable(c Collection) {
+	sort.Stable(c)
+}
+
+// ParseVersions parses each of raw into a Version, returning an error from
+// the first one that fails to parse.
+func ParseVersions(raw []string) (Collection, error) {
+	c := make(Collection, len(raw))
+	for i, s := range raw {
+		v, err := NewVersion(s)
+		if err != nil {
+			return nil, err
+		}
+		c[i] = v
+	}
+	return c, nil
+}
+
+// Strings returns the String() form of every Version in c, in order.
+func (v Collection) Strings() []string {
+	strs := make([]string, len(v))
+	for i, ver := range v {
+		strs[i] = ver.String()
+	}
+	return strs
+}
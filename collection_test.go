@@ -0,0 +1,58 @@
+package version
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestCollectionSort(t *testing.T) {
+	c, err := ParseVersions([]string{
+		"1.2.3",
+		"1.0.0",
+		"2.0.0-beta.2",
+		"2.0.0-beta.1",
+		"2.0.0",
+		"1.2.3-alpha",
+	})
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	Sort(c)
+
+	expected := []string{
+		"1.0.0",
+		"1.2.3-alpha",
+		"1.2.3",
+		"2.0.0-beta.1",
+		"2.0.0-beta.2",
+		"2.0.0",
+	}
+
+	if !reflect.DeepEqual(c.Strings(), expected) {
+		t.Fatalf("expected: %#v\nactual: %#v", expected, c.Strings())
+	}
+
+	if !sort.IsSorted(c) {
+		t.Fatalf("expected Collection to be sorted")
+	}
+}
+
+func TestCollectionSortStable(t *testing.T) {
+	v1 := Must(NewVersion("1.2.3+build.1"))
+	v2 := Must(NewVersion("1.2.3+build.2"))
+	c := Collection{v1, v2}
+
+	SortStable(c)
+
+	if c[0] != v1 || c[1] != v2 {
+		t.Fatalf("expected SortStable to preserve order of build-metadata-only differences")
+	}
+}
+
+func TestParseVersionsError(t *testing.T) {
+	if _, err := ParseVersions([]string{"1.2.3", "not-a-version"}); err == nil {
+		t.Fatal("expected error for malformed version")
+	}
+}
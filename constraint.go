@@ -0,0 +1,366 @@
+package version
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Constraint is a single, unary comparison compiled from an expression such
+// as ">=1.2.3" or "~1.2". It is built on top of Compare.
+type Constraint struct {
+	original string
+	version  *Version
+	check    func(v *Version) bool
+}
+
+// String returns the original text the Constraint was parsed from.
+func (c *Constraint) String() string {
+	return c.original
+}
+
+// sharesPrereleaseCore reports whether operand is a prerelease version with
+// the same major.minor.patch core as v. operand may be nil (e.g. for a "*"
+// constraint), in which case it never shares a core.
+func sharesPrereleaseCore(v *Version, operand *Version) bool {
+	if operand == nil || !operand.IsPrerelease() {
+		return false
+	}
+	return v.Core().Equal(operand.Core())
+}
+
+// Constraints is a set of Constraint and-groups, any one of which may be
+// satisfied: (c1 AND c2) OR (c3) OR ... Within a group, AND binds tighter
+// than OR, e.g. ">=1.0 <2.0 || >=3.0" means "(>=1.0 AND <2.0) OR (>=3.0)".
+type Constraints struct {
+	orGroups          [][]*Constraint
+	includePrerelease bool
+}
+
+// ConstraintOption configures a Constraints set constructed by NewConstraint.
+type ConstraintOption func(*Constraints)
+
+// WithPrerelease makes the resulting Constraints satisfy prerelease versions
+// even when none of its comparators carry a prerelease with a matching core.
+func WithPrerelease() ConstraintOption {
+	return func(c *Constraints) {
+		c.includePrerelease = true
+	}
+}
+
+// Check reports whether v satisfies any of the and-groups in c. Unless c was
+// built with WithPrerelease, a prerelease version is only considered for a
+// given and-group if at least one of that group's comparators itself has a
+// prerelease operand with a matching major.minor.patch core (the standard
+// SemVer rule for prerelease visibility).
+func (c Constraints) Check(v *Version) bool {
+	for _, group := range c.orGroups {
+		if v.pre != "" && !c.includePrerelease && !groupAllowsPrerelease(group, v) {
+			continue
+		}
+		if allConstraintsMatch(group, v) {
+			return true
+		}
+	}
+	return false
+}
+
+func groupAllowsPrerelease(group []*Constraint, v *Version) bool {
+	for _, c := range group {
+		if sharesPrereleaseCore(v, c.version) {
+			return true
+		}
+	}
+	return false
+}
+
+func allConstraintsMatch(group []*Constraint, v *Version) bool {
+	for _, c := range group {
+		if !c.check(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Satisfies reports whether v satisfies c. It is equivalent to c.Check(v).
+func (v *Version) Satisfies(c Constraints) bool {
+	return c.Check(v)
+}
+
+// constraintOperatorRegexp splits a single comparator token into its
+// (optional) operator and version spec, e.g. ">=1.2.3" -> (">=", "1.2.3").
+var constraintOperatorRegexp = regexp.MustCompile(`^(>=|<=|!=|=|>|<|~|\^)?(.*)$`)
+
+// NewConstraint parses a constraint expression such as ">=1.2.0 <2.0.0",
+// "~1.2.3", "^1.2.3", "1.2.x" or ">1.0 || <0.9" into a Constraints value.
+// Comparators are joined by AND using whitespace or commas; "||" joins
+// and-groups with OR, which has lower precedence than AND.
+func NewConstraint(v string, opts ...ConstraintOption) (Constraints, error) {
+	var cs Constraints
+	for _, opt := range opts {
+		opt(&cs)
+	}
+
+	for _, orPart := range strings.Split(v, "||") {
+		tokens := splitConstraintTokens(orPart)
+		if len(tokens) == 0 {
+			return Constraints{}, fmt.Errorf("improperly formatted constraint string: %s", v)
+		}
+
+		var group []*Constraint
+		for _, token := range tokens {
+			parsed, err := parseConstraintToken(token)
+			if err != nil {
+				return Constraints{}, err
+			}
+			group = append(group, parsed...)
+		}
+		cs.orGroups = append(cs.orGroups, group)
+	}
+
+	return cs, nil
+}
+
+// splitConstraintTokens splits an and-group into its individual comparator
+// tokens. Comparators may be separated by commas, whitespace, or both.
+func splitConstraintTokens(s string) []string {
+	return strings.Fields(strings.ReplaceAll(s, ",", " "))
+}
+
+// parseConstraintToken parses a single comparator token, expanding tilde,
+// caret, and wildcard shorthand into the pair of comparators they desugar to.
+func parseConstraintToken(token string) ([]*Constraint, error) {
+	m := constraintOperatorRegexp.FindStringSubmatch(token)
+	if m == nil {
+		return nil, fmt.Errorf("malformed constraint: %s", token)
+	}
+
+	operator := m[1]
+	spec := strings.TrimSpace(m[2])
+	if spec == "" {
+		return nil, fmt.Errorf("malformed constraint: %s", token)
+	}
+
+	segs, explicit, wildcard, pre, err := parseConstraintVersion(spec)
+	if err != nil {
+		return nil, fmt.Errorf("malformed constraint %q: %s", token, err)
+	}
+
+	switch operator {
+	case "~":
+		if wildcard >= 0 {
+			return nil, fmt.Errorf("malformed constraint %q: ~ cannot be combined with a wildcard version", token)
+		}
+		return tildeRange(token, segs, explicit, pre)
+	case "^":
+		if wildcard >= 0 {
+			return nil, fmt.Errorf("malformed constraint %q: ^ cannot be combined with a wildcard version", token)
+		}
+		return caretRange(token, segs, pre)
+	case "", "=":
+		if wildcard >= 0 {
+			return wildcardRange(token, segs, wildcard, pre)
+		}
+	default:
+		if wildcard >= 0 {
+			return nil, fmt.Errorf("malformed constraint %q: operator %s cannot be combined with a wildcard version", token, operator)
+		}
+	}
+
+	target, err := NewVersion(versionSpecString(segs, pre))
+	if err != nil {
+		return nil, fmt.Errorf("malformed constraint %q: %s", token, err)
+	}
+
+	c, err := newConstraint(token, operator, target)
+	if err != nil {
+		return nil, fmt.Errorf("malformed constraint %q: %s", token, err)
+	}
+	return []*Constraint{c}, nil
+}
+
+// parseConstraintVersion parses the version spec of a comparator token
+// (everything after the operator) into its numeric segments, padded to
+// three entries, along with how many segments were explicitly given, the
+// index of a wildcard segment (x, X, or *) if any (-1 if none), and any
+// prerelease suffix.
+func parseConstraintVersion(spec string) (segs []int, explicit int, wildcard int, pre string, err error) {
+	core := spec
+	if idx := strings.Index(spec, "-"); idx >= 0 {
+		core = spec[:idx]
+		pre = spec[idx+1:]
+	}
+
+	parts := strings.Split(core, ".")
+	if len(parts) > 3 {
+		return nil, 0, -1, "", fmt.Errorf("too many version segments: %s", spec)
+	}
+
+	wildcard = -1
+	segs = make([]int, 0, 3)
+	for i, p := range parts {
+		if p == "x" || p == "X" || p == "*" {
+			if wildcard >= 0 {
+				return nil, 0, -1, "", fmt.Errorf("only one wildcard segment is allowed: %s", spec)
+			}
+			wildcard = i
+			continue
+		}
+		if wildcard >= 0 {
+			return nil, 0, -1, "", fmt.Errorf("numeric segment after wildcard: %s", spec)
+		}
+
+		val, convErr := strconv.Atoi(p)
+		if convErr != nil {
+			return nil, 0, -1, "", fmt.Errorf("invalid version segment %q", p)
+		}
+		segs = append(segs, val)
+	}
+
+	explicit = len(parts)
+	if wildcard >= 0 {
+		explicit = wildcard
+	}
+
+	for len(segs) < 3 {
+		segs = append(segs, 0)
+	}
+
+	return segs, explicit, wildcard, pre, nil
+}
+
+// tildeRange expands "~1.2.3" into the pair of comparators ">=1.2.3 <1.3.0".
+// With fewer explicit segments the trailing ones are zeroed, so "~1.2"
+// expands to ">=1.2.0 <1.3.0" (the same upper bound as "~1.2.3"), while "~1"
+// widens to the whole major.
+// A prerelease suffix on the spec (e.g. "~1.2.3-beta") is kept on the lower
+// bound, so the constraint still matches the exact version the caller wrote.
+func tildeRange(token string, segs []int, explicit int, pre string) ([]*Constraint, error) {
+	low := append([]int(nil), segs...)
+	high := append([]int(nil), segs...)
+
+	if explicit <= 1 {
+		high[0]++
+		high[1] = 0
+	} else {
+		high[1]++
+	}
+	high[2] = 0
+
+	return rangeConstraints(token, low, high, pre)
+}
+
+// caretRange expands "^1.2.3" into ">=1.2.3 <2.0.0", allowing changes that
+// don't modify the left-most non-zero segment (the SemVer "compatible
+// changes" rule), e.g. "^0.2.3" -> ">=0.2.3 <0.3.0". A prerelease suffix on
+// the spec is kept on the lower bound, as in tildeRange.
+func caretRange(token string, segs []int, pre string) ([]*Constraint, error) {
+	low := append([]int(nil), segs...)
+	high := append([]int(nil), segs...)
+
+	switch {
+	case segs[0] != 0:
+		high[0]++
+		high[1] = 0
+		high[2] = 0
+	case segs[1] != 0:
+		high[1]++
+		high[2] = 0
+	default:
+		high[2]++
+	}
+
+	return rangeConstraints(token, low, high, pre)
+}
+
+// wildcardRange expands "1.2.x" / "1.2.*" into ">=1.2.0 <1.3.0", and a bare
+// wildcard ("x", "*") into a constraint that matches every release version.
+// A prerelease suffix on the spec is kept on the lower bound, as in
+// tildeRange; it has no effect on a bare wildcard, which already matches
+// everything.
+func wildcardRange(token string, segs []int, wildcard int, pre string) ([]*Constraint, error) {
+	if wildcard == 0 {
+		return []*Constraint{{original: token, check: func(v *Version) bool { return true }}}, nil
+	}
+
+	low := append([]int(nil), segs...)
+	high := append([]int(nil), segs...)
+
+	bump := wildcard - 1
+	high[bump]++
+	for i := bump + 1; i < len(high); i++ {
+		high[i] = 0
+	}
+
+	return rangeConstraints(token, low, high, pre)
+}
+
+// rangeConstraints builds the ">= low" and "< high" comparator pair that
+// tilde, caret, and wildcard expressions desugar to. pre, if non-empty, is
+// attached to the low bound so the expansion still matches the exact
+// prerelease version the caller spelled out, e.g. "~1.2.3-beta" desugars to
+// ">=1.2.3-beta <1.3.0" rather than silently dropping "-beta".
+func rangeConstraints(token string, low, high []int, pre string) ([]*Constraint, error) {
+	lowV, err := NewVersion(versionSpecString(low, pre))
+	if err != nil {
+		return nil, fmt.Errorf("malformed constraint %q: %s", token, err)
+	}
+	highV, err := NewVersion(intsToVersionString(high))
+	if err != nil {
+		return nil, fmt.Errorf("malformed constraint %q: %s", token, err)
+	}
+
+	lowC, err := newConstraint(token, ">=", lowV)
+	if err != nil {
+		return nil, err
+	}
+	highC, err := newConstraint(token, "<", highV)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*Constraint{lowC, highC}, nil
+}
+
+// newConstraint compiles operator and target into a Constraint whose check
+// closure is built on Compare.
+func newConstraint(original string, operator string, target *Version) (*Constraint, error) {
+	var check func(v *Version) bool
+
+	switch operator {
+	case "", "=":
+		check = func(v *Version) bool { return v.Compare(target) == 0 }
+	case "!=":
+		check = func(v *Version) bool { return v.Compare(target) != 0 }
+	case ">":
+		check = func(v *Version) bool { return v.Compare(target) > 0 }
+	case ">=":
+		check = func(v *Version) bool { return v.Compare(target) >= 0 }
+	case "<":
+		check = func(v *Version) bool { return v.Compare(target) < 0 }
+	case "<=":
+		check = func(v *Version) bool { return v.Compare(target) <= 0 }
+	default:
+		return nil, fmt.Errorf("unknown constraint operator: %s", operator)
+	}
+
+	return &Constraint{original: original, version: target, check: check}, nil
+}
+
+func intsToVersionString(segs []int) string {
+	parts := make([]string, len(segs))
+	for i, s := range segs {
+		parts[i] = strconv.Itoa(s)
+	}
+	return strings.Join(parts, ".")
+}
+
+func versionSpecString(segs []int, pre string) string {
+	s := intsToVersionString(segs)
+	if pre != "" {
+		s += "-" + pre
+	}
+	return s
+}
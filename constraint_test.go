@@ -0,0 +1,185 @@
+package version
+
+import "testing"
+
+func TestNewConstraintCheck(t *testing.T) {
+	cases := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{">=1.2.0 <2.0.0", "1.2.0", true},
+		{">=1.2.0 <2.0.0", "1.9.9", true},
+		{">=1.2.0 <2.0.0", "2.0.0", false},
+		{">=1.2.0 <2.0.0", "1.1.9", false},
+		{">=1.2.0, <2.0.0", "1.5.0", true},
+		{">1.0 || <0.9", "1.1.0", true},
+		{">1.0 || <0.9", "0.5.0", true},
+		{">1.0 || <0.9", "0.9.5", false},
+		{"=1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2.3", true},
+		{"!=1.2.3", "1.2.3", false},
+		{"!=1.2.3", "1.2.4", true},
+	}
+
+	for _, tc := range cases {
+		c, err := NewConstraint(tc.constraint)
+		if err != nil {
+			t.Fatalf("err parsing constraint %q: %s", tc.constraint, err)
+		}
+
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("err parsing version %q: %s", tc.version, err)
+		}
+
+		actual := c.Check(v)
+		if actual != tc.expected {
+			t.Fatalf("%q.Check(%q): expected %v, got %v", tc.constraint, tc.version, tc.expected, actual)
+		}
+
+		if v.Satisfies(c) != tc.expected {
+			t.Fatalf("%q.Satisfies(%q): expected %v, got %v", tc.version, tc.constraint, tc.expected, !tc.expected)
+		}
+	}
+}
+
+func TestNewConstraintTildeCaret(t *testing.T) {
+	cases := []struct {
+		constraint string
+		version    string
+		expected   bool
+	}{
+		{"~1.2.3", "1.2.3", true},
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2.3", "1.2.2", false},
+		{"~1.2", "1.2.9", true},
+		{"~1.2", "1.3.0", false},
+		{"~1", "1.9.9", true},
+		{"~1", "2.0.0", false},
+		{"^1.2.3", "1.2.3", true},
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^1.2.3", "1.2.2", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+		{"1.2.x", "1.2.0", true},
+		{"1.2.x", "1.2.9", true},
+		{"1.2.x", "1.3.0", false},
+		{"1.2.*", "1.2.5", true},
+		{"1.x", "1.9.9", true},
+		{"1.x", "2.0.0", false},
+		{"*", "9.9.9", true},
+		{"~1.2.3-beta", "1.2.3-beta", true},
+		{"~1.2.3-beta", "1.2.3", true},
+		{"~1.2.3-beta", "1.2.3-alpha", false},
+		{"~1.2.3-beta", "1.3.0", false},
+	}
+
+	for _, tc := range cases {
+		c, err := NewConstraint(tc.constraint)
+		if err != nil {
+			t.Fatalf("err parsing constraint %q: %s", tc.constraint, err)
+		}
+
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("err parsing version %q: %s", tc.version, err)
+		}
+
+		actual := c.Check(v)
+		if actual != tc.expected {
+			t.Fatalf("%q.Check(%q): expected %v, got %v", tc.constraint, tc.version, tc.expected, actual)
+		}
+	}
+}
+
+func TestNewConstraintOperatorPrecedence(t *testing.T) {
+	c, err := NewConstraint(">=1.0.0 <2.0.0 || >=3.0.0 <4.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		version  string
+		expected bool
+	}{
+		{"1.5.0", true},
+		{"2.5.0", false},
+		{"3.5.0", true},
+		{"4.5.0", false},
+	}
+
+	for _, tc := range cases {
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if actual := c.Check(v); actual != tc.expected {
+			t.Fatalf("%q: expected %v, got %v", tc.version, tc.expected, actual)
+		}
+	}
+}
+
+func TestNewConstraintPrereleaseExclusion(t *testing.T) {
+	c, err := NewConstraint(">=1.2.0-beta <2.0.0")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	cases := []struct {
+		version  string
+		expected bool
+	}{
+		{"1.2.0-beta", true},
+		{"1.2.0-beta.2", true},
+		{"1.5.0-alpha", false},
+		{"1.5.0", true},
+	}
+
+	for _, tc := range cases {
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if actual := c.Check(v); actual != tc.expected {
+			t.Fatalf("%q: expected %v, got %v", tc.version, tc.expected, actual)
+		}
+	}
+
+	withPre, err := NewConstraint(">=1.2.0 <2.0.0", WithPrerelease())
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	v, err := NewVersion("1.5.0-alpha")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if !withPre.Check(v) {
+		t.Fatalf("expected WithPrerelease constraint to match %q", v)
+	}
+}
+
+func TestNewConstraintMalformed(t *testing.T) {
+	cases := []string{
+		"",
+		">= ",
+		"1.2.3.4",
+		"~",
+		"1.x.2",
+		">=1.2.x",
+	}
+
+	for _, tc := range cases {
+		if _, err := NewConstraint(tc); err == nil {
+			t.Fatalf("expected error for constraint %q", tc)
+		}
+	}
+}
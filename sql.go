@@ -0,0 +1,37 @@
+package version
+
+import (
+	"database/sql/driver"
+	"fmt"
+)
+
+// Scan implements the database/sql Scanner interface, so a *Version can be
+// read directly out of a TEXT/VARCHAR column.
+func (v *Version) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case nil:
+		*v = Version{segments: []int{0, 0, 0}}
+		return nil
+	case string:
+		return v.scanString(s)
+	case []byte:
+		return v.scanString(string(s))
+	default:
+		return fmt.Errorf("version: cannot scan type %T into Version", src)
+	}
+}
+
+func (v *Version) scanString(s string) error {
+	nv, err := NewVersion(s)
+	if err != nil {
+		return fmt.Errorf("version: scanning %q: %s", s, err)
+	}
+	*v = *nv
+	return nil
+}
+
+// Value implements the database/sql/driver Valuer interface, so a *Version
+// can be written directly into a TEXT/VARCHAR column.
+func (v *Version) Value() (driver.Value, error) {
+	return v.String(), nil
+}
@@ -0,0 +1,89 @@
+package version
+
+import "testing"
+
+func TestVersionScan(t *testing.T) {
+	cases := []struct {
+		src      interface{}
+		expected string
+		err      bool
+	}{
+		{"1.2.3", "1.2.3", false},
+		{[]byte("1.2.3-beta.1+build.5"), "1.2.3-beta.1+build.5", false},
+		{nil, "0.0.0", false},
+		{"not-a-version", "", true},
+		{42, "", true},
+	}
+
+	for _, tc := range cases {
+		var v Version
+		err := v.Scan(tc.src)
+		if tc.err {
+			if err == nil {
+				t.Fatalf("expected error scanning %#v", tc.src)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("err scanning %#v: %s", tc.src, err)
+		}
+		if v.String() != tc.expected {
+			t.Fatalf("expected %s, got %s", tc.expected, v.String())
+		}
+	}
+}
+
+func TestVersionScanNilIsUsable(t *testing.T) {
+	var v Version
+	if err := v.Scan(nil); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	// Scan(nil) must leave v in a state where the rest of the API doesn't
+	// panic, since a SQL NULL is an ordinary, expected input.
+	_ = v.String()
+	_ = v.Compare(Must(NewVersion("1.0.0")))
+
+	if _, err := v.Value(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if _, err := v.MarshalJSON(); err != nil {
+		t.Fatalf("err: %s", err)
+	}
+}
+
+func TestVersionValue(t *testing.T) {
+	cases := []string{
+		"1.2.3",
+		"1.2.3-beta.1",
+		"1.2.3+build.5",
+		"1.2.3-beta.1+build.5",
+	}
+
+	for _, vs := range cases {
+		v, err := NewVersion(vs)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		value, err := v.Value()
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		s, ok := value.(string)
+		if !ok {
+			t.Fatalf("expected Value() to return a string, got %T", value)
+		}
+
+		roundTripped, err := NewVersion(s)
+		if err != nil {
+			t.Fatalf("err round-tripping %q: %s", s, err)
+		}
+
+		if !roundTripped.Equal(v) || roundTripped.Metadata() != v.Metadata() {
+			t.Fatalf("round trip mismatch: %s -> %s -> %s", v, s, roundTripped)
+		}
+	}
+}
@@ -14,8 +14,10 @@ import (
 var versionRegexp *regexp.Regexp
 
 // The raw regular expression string used for testing the validity
-// of a version.
-const VersionRegexpRaw string = `([0-9]+(\.[0-9]+){0,2})` +
+// of a version. A leading "v" is tolerated (but not required), as is
+// common for Git tags and Go module versions, e.g. "v1.2.3".
+const VersionRegexpRaw string = `v?` +
+	`([0-9]+(\.[0-9]+){0,2})` +
 	`(-([0-9A-Za-z\-]+(\.[0-9A-Za-z\-]+)*))?` +
 	`(\+([0-9A-Za-z\-]+(\.[0-9A-Za-z\-]+)*))?` +
 	`?`
@@ -28,15 +30,23 @@ const (
 	PatchPart
 	PreReleasePart
 	MetadataPart
+	// BumpPrereleasePart is passed to BumpVersion to increment the trailing
+	// numeric identifier of the prerelease instead of the core version.
+	BumpPrereleasePart
 )
 
 var partNames = [...]string{
-	"major", "minor", "patch", "prerelease", "metadata",
+	"major", "minor", "patch", "prerelease", "metadata", "prerelease-bump",
 }
 
+// identifierListRegexp matches the dot-separated [0-9A-Za-z-]+ grammar that
+// VersionRegexpRaw uses for prerelease and metadata segments.
+var identifierListRegexp = regexp.MustCompile(`^[0-9A-Za-z\-]+(\.[0-9A-Za-z\-]+)*$`)
+
 // Version represents a single version.
 type Version struct {
 	metadata string
+	original string
 	pre      string
 	segments []int
 	si       int
@@ -47,12 +57,26 @@ func init() {
 }
 
 // NewVersion parses the given version and returns a new
-// Version.
+// Version. A leading "v" (e.g. "v1.2.3") is accepted, as is common for Git
+// tags and Go module versions; use NewVersionStrict to reject it.
 func NewVersion(v string) (*Version, error) {
+	return newVersion(v, true)
+}
+
+// NewVersionStrict parses the given version like NewVersion, but rejects a
+// leading "v" for callers that want to enforce plain SemVer input.
+func NewVersionStrict(v string) (*Version, error) {
+	return newVersion(v, false)
+}
+
+func newVersion(v string, allowV bool) (*Version, error) {
 	matches := versionRegexp.FindStringSubmatch(v)
 	if matches == nil {
 		return nil, fmt.Errorf("Malformed version: %s", v)
 	}
+	if !allowV && strings.HasPrefix(v, "v") {
+		return nil, fmt.Errorf("Malformed version: %s", v)
+	}
 
 	segmentsStr := strings.Split(matches[1], ".")
 	segments := make([]int, len(segmentsStr), 3)
@@ -73,6 +97,7 @@ func NewVersion(v string) (*Version, error) {
 
 	return &Version{
 		metadata: matches[7],
+		original: v,
 		pre:      matches[4],
 		segments: segments,
 		si:       si,
@@ -231,6 +256,37 @@ func (v *Version) Metadata() string {
 	return v.metadata
 }
 
+// IsPrerelease reports whether v carries prerelease information.
+func (v *Version) IsPrerelease() bool {
+	return v.pre != ""
+}
+
+// IsStable reports whether v is a stable release: no prerelease information
+// and a major version of at least 1.
+func (v *Version) IsStable() bool {
+	return v.pre == "" && v.segments[0] >= 1
+}
+
+// Core returns a copy of v with its prerelease and metadata stripped, i.e.
+// just major.minor.patch. It's useful for comparing or filtering on the
+// numeric core of a version while ignoring how it was tagged.
+func (v *Version) Core() *Version {
+	core := &Version{
+		segments: append([]int(nil), v.segments...),
+		si:       v.si,
+	}
+	core.original = core.String()
+	return core
+}
+
+// Original returns the original string that was passed in to NewVersion or
+// NewVersionStrict, leading "v" and all. This allows callers to round-trip
+// tag names verbatim (e.g. when re-emitting them into a changelog or Git
+// command) instead of using the canonical form returned by String().
+func (v *Version) Original() string {
+	return v.original
+}
+
 // Prerelease returns any prerelease data that is part of the version,
 // or blank if there is no prerelease data.
 //
@@ -276,10 +332,43 @@ func (v *Version) SetPart(part VersionPart, val int) error {
 	return nil
 }
 
+// SetPrerelease validates pre against the dot-separated [0-9A-Za-z-]+
+// grammar used by VersionRegexpRaw and, if it's valid, sets it as v's
+// prerelease information. Pass an empty string to clear it.
+func (v *Version) SetPrerelease(pre string) error {
+	if pre != "" && !identifierListRegexp.MatchString(pre) {
+		return fmt.Errorf("invalid prerelease string: %s", pre)
+	}
+	v.pre = pre
+	return nil
+}
+
+// SetMetadata validates metadata against the same grammar as SetPrerelease
+// and, if it's valid, sets it as v's build metadata. Pass an empty string
+// to clear it.
+func (v *Version) SetMetadata(metadata string) error {
+	if metadata != "" && !identifierListRegexp.MatchString(metadata) {
+		return fmt.Errorf("invalid metadata string: %s", metadata)
+	}
+	v.metadata = metadata
+	return nil
+}
+
 // BumpVersion does the same as BumpPart but resets all lesser parts to 0.
+//
+// Bumping MajorPart, MinorPart, or PatchPart clears any prerelease and
+// metadata, per the SemVer rule that a prereleased version is superseded by
+// the plain release of the same or any later core version. Passing
+// BumpPrereleasePart instead leaves the core version untouched and
+// increments the trailing numeric identifier of the prerelease (appending
+// ".1" if it doesn't have one), e.g. "1.2.3-beta.1" becomes "1.2.3-beta.2".
 func (v *Version) BumpVersion(part VersionPart) error {
 	const reset = 0
 
+	if part == BumpPrereleasePart {
+		return v.bumpPrereleaseNumeric()
+	}
+
 	if part <= PatchPart && (v.pre != "" || v.metadata != "") {
 		v.pre = ""
 		v.metadata = ""
@@ -297,6 +386,24 @@ func (v *Version) BumpVersion(part VersionPart) error {
 	return v.BumpPart(part)
 }
 
+// bumpPrereleaseNumeric increments the trailing numeric identifier of v's
+// prerelease, appending ".1" if it doesn't already end in one.
+func (v *Version) bumpPrereleaseNumeric() error {
+	if v.pre == "" {
+		return fmt.Errorf("unable to bump prerelease part of version %q: no prerelease present", v)
+	}
+
+	parts := strings.Split(v.pre, ".")
+	last := parts[len(parts)-1]
+	if n, err := strconv.Atoi(last); err == nil {
+		parts[len(parts)-1] = strconv.Itoa(n + 1)
+	} else {
+		parts = append(parts, "1")
+	}
+
+	return v.SetPrerelease(strings.Join(parts, "."))
+}
+
 // BumpPart increments the indicated part by 1.
 // part may be one of: MajorPart, MinorPart or PatchPart
 func (v *Version) BumpPart(part VersionPart) (err error) {
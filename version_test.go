@@ -24,6 +24,8 @@ func TestNewVersion(t *testing.T) {
 		{"1.2.0-x.Y.0+metadata-width-hypen", false},
 		{"1.2.3-rc1-with-hypen", false},
 		{"1.2.3.4", true},
+		{"v1.2.3", false},
+		{"v1.2.0-beta", false},
 	}
 
 	for _, tc := range cases {
@@ -242,6 +244,67 @@ func TestSetPart(t *testing.T) {
 	}
 }
 
+func TestSetPrerelease(t *testing.T) {
+	cases := []struct {
+		pre string
+		err bool
+	}{
+		{"beta", false},
+		{"beta.1", false},
+		{"x.Y.0", false},
+		{"", false},
+		{"beta..1", true},
+		{"beta_1", true},
+	}
+
+	for _, tc := range cases {
+		v, err := NewVersion("1.2.3")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		err = v.SetPrerelease(tc.pre)
+		if tc.err && err == nil {
+			t.Fatalf("expected error for prerelease: %s", tc.pre)
+		} else if !tc.err && err != nil {
+			t.Fatalf("error for prerelease %s: %s", tc.pre, err)
+		}
+		if !tc.err && v.Prerelease() != tc.pre {
+			t.Fatalf("expected prerelease %s, got %s", tc.pre, v.Prerelease())
+		}
+	}
+}
+
+func TestSetMetadata(t *testing.T) {
+	cases := []struct {
+		metadata string
+		err      bool
+	}{
+		{"build.5", false},
+		{"exp.sha.5114f85", false},
+		{"", false},
+		{"build..5", true},
+		{"build 5", true},
+	}
+
+	for _, tc := range cases {
+		v, err := NewVersion("1.2.3")
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		err = v.SetMetadata(tc.metadata)
+		if tc.err && err == nil {
+			t.Fatalf("expected error for metadata: %s", tc.metadata)
+		} else if !tc.err && err != nil {
+			t.Fatalf("error for metadata %s: %s", tc.metadata, err)
+		}
+		if !tc.err && v.Metadata() != tc.metadata {
+			t.Fatalf("expected metadata %s, got %s", tc.metadata, v.Metadata())
+		}
+	}
+}
+
 func TestBumpVersion(t *testing.T) {
 	cases := []struct {
 		version string
@@ -249,14 +312,20 @@ func TestBumpVersion(t *testing.T) {
 		result  string
 		err     bool
 	}{
-		{"1.1.1", MajorPart, "2.1.1", false},
-		{"1.1.1", MinorPart, "1.2.1", false},
+		{"1.1.1", MajorPart, "2.0.0", false},
+		{"1.1.1", MinorPart, "1.2.0", false},
 		{"1.1.1", PatchPart, "1.1.2", false},
 		{"2", MinorPart, "2.1.0", false},
 		{"2.2", PatchPart, "2.2.1", false},
-		{"1.1.0-beta1", MinorPart, "1.2.0-beta1", false},
+		{"1.1.0-beta1", MinorPart, "1.2.0", false},
 		{"1.1.0-beta1", PreReleasePart, "", true},
 		{"1.1.0-beta1+foo", MetadataPart, "", true},
+		{"1.2.3-beta.1", MajorPart, "2.0.0", false},
+		{"1.2.3-beta.1", MinorPart, "1.3.0", false},
+		{"1.2.3-beta.1", PatchPart, "1.2.4", false},
+		{"1.2.3-beta.1", BumpPrereleasePart, "1.2.3-beta.2", false},
+		{"1.2.3-beta", BumpPrereleasePart, "1.2.3-beta.1", false},
+		{"1.2.3", BumpPrereleasePart, "", true},
 	}
 
 	for _, tc := range cases {
@@ -278,6 +347,113 @@ func TestBumpVersion(t *testing.T) {
 	}
 }
 
+func TestVersionIsPrereleaseIsStable(t *testing.T) {
+	cases := []struct {
+		version      string
+		isPrerelease bool
+		isStable     bool
+	}{
+		{"1.2.3", false, true},
+		{"1.2.3-beta", true, false},
+		{"0.9.0", false, false},
+		{"0.9.0-beta", true, false},
+		{"2.0.0+build", false, true},
+	}
+
+	for _, tc := range cases {
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if actual := v.IsPrerelease(); actual != tc.isPrerelease {
+			t.Fatalf("%s: IsPrerelease() expected %v, got %v", tc.version, tc.isPrerelease, actual)
+		}
+		if actual := v.IsStable(); actual != tc.isStable {
+			t.Fatalf("%s: IsStable() expected %v, got %v", tc.version, tc.isStable, actual)
+		}
+	}
+}
+
+func TestVersionCore(t *testing.T) {
+	cases := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"1.2.3-beta", "1.2.3"},
+		{"1.2.3+build.5", "1.2.3"},
+		{"1.2.3-beta+build.5", "1.2.3"},
+	}
+
+	for _, tc := range cases {
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		core := v.Core()
+		if core.String() != tc.expected {
+			t.Fatalf("%s: Core() expected %s, got %s", tc.version, tc.expected, core.String())
+		}
+		if core.IsPrerelease() {
+			t.Fatalf("%s: Core() should not carry prerelease information", tc.version)
+		}
+	}
+}
+
+func TestVersionOriginal(t *testing.T) {
+	cases := []struct {
+		version  string
+		expected string
+	}{
+		{"1.2.3", "1.2.3"},
+		{"v1.2.3", "v1.2.3"},
+		{"v1.2.0-beta", "v1.2.0-beta"},
+	}
+
+	for _, tc := range cases {
+		v, err := NewVersion(tc.version)
+		if err != nil {
+			t.Fatalf("err: %s", err)
+		}
+
+		if actual := v.Original(); actual != tc.expected {
+			t.Fatalf("expected: %s\nactual: %s", tc.expected, actual)
+		}
+	}
+}
+
+func TestVersionVPrefixString(t *testing.T) {
+	v, err := NewVersion("v1.2.3")
+	if err != nil {
+		t.Fatalf("err: %s", err)
+	}
+
+	if v.String() != "1.2.3" {
+		t.Fatalf("expected String() to drop the leading v, got %s", v.String())
+	}
+}
+
+func TestNewVersionStrict(t *testing.T) {
+	cases := []struct {
+		version string
+		err     bool
+	}{
+		{"1.2.3", false},
+		{"v1.2.3", true},
+	}
+
+	for _, tc := range cases {
+		_, err := NewVersionStrict(tc.version)
+		if tc.err && err == nil {
+			t.Fatalf("expected error for version: %s", tc.version)
+		} else if !tc.err && err != nil {
+			t.Fatalf("error for version %s: %s", tc.version, err)
+		}
+	}
+}
+
 func TestVersionJSON(t *testing.T) {
 	type MyStruct struct {
 		Ver *Version